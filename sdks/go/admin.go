@@ -0,0 +1,226 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+)
+
+// requireWriteAccess returns an AuthorizationError without a round trip if
+// the caller's cached role is already known to be read-only. A nil cached
+// role means we haven't seen GetCurrentUser yet; in that case the request
+// is sent and the server has the final say.
+func (c *Client) requireWriteAccess() error {
+	if c.cachedRole != nil && *c.cachedRole == UserRoleAuditViewer {
+		return NewAuthorizationError("audit_viewer role cannot perform write operations")
+	}
+	return nil
+}
+
+// UsersAPI groups the admin operations for managing users.
+type UsersAPI struct {
+	client *Client
+}
+
+// Users returns the admin API for managing users.
+func (c *Client) Users() *UsersAPI {
+	return &UsersAPI{client: c}
+}
+
+// Create creates a new user.
+func (u *UsersAPI) Create(ctx context.Context, user *UserCreate) (*UserResponse, error) {
+	if err := u.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+	return u.client.CreateUser(ctx, user)
+}
+
+// Get gets a user by ID.
+func (u *UsersAPI) Get(ctx context.Context, userID string) (*UserResponse, error) {
+	return u.client.GetUser(ctx, userID)
+}
+
+// List lists users for the caller's tenant.
+func (u *UsersAPI) List(ctx context.Context, page, size int) ([]UserResponse, error) {
+	resp, err := u.client.makeRequest(ctx, "GET", "/api/v1/auth/users", nil, map[string]string{
+		"page": fmt.Sprintf("%d", page),
+		"size": fmt.Sprintf("%d", size),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var users []UserResponse
+	if err := u.client.parseResponse(resp, &users); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse users response: %v", err))
+	}
+	return users, nil
+}
+
+// Update updates a user.
+func (u *UsersAPI) Update(ctx context.Context, userID string, update *UserUpdate) (*UserResponse, error) {
+	if err := u.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+	return u.client.UpdateUser(ctx, userID, update)
+}
+
+// Delete deactivates a user.
+func (u *UsersAPI) Delete(ctx context.Context, userID string) (*UserResponse, error) {
+	if err := u.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+	return u.client.DeactivateUser(ctx, userID)
+}
+
+// APIKeysAPI groups the admin operations for managing API keys.
+type APIKeysAPI struct {
+	client *Client
+}
+
+// APIKeys returns the admin API for managing API keys.
+func (c *Client) APIKeys() *APIKeysAPI {
+	return &APIKeysAPI{client: c}
+}
+
+// Create creates a new API key. The plaintext key is only present on
+// APIKeyResponse.Key for this call.
+func (a *APIKeysAPI) Create(ctx context.Context, apiKey *APIKeyCreate) (*APIKeyResponse, error) {
+	if err := a.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+	return a.client.CreateAPIKey(ctx, apiKey)
+}
+
+// List lists API keys for the caller's tenant. The plaintext key is never
+// included in list results.
+func (a *APIKeysAPI) List(ctx context.Context) ([]APIKeyResponse, error) {
+	resp, err := a.client.makeRequest(ctx, "GET", "/api/v1/auth/api-keys", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []APIKeyResponse
+	if err := a.client.parseResponse(resp, &keys); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse API keys response: %v", err))
+	}
+	return keys, nil
+}
+
+// Revoke revokes an API key by ID.
+func (a *APIKeysAPI) Revoke(ctx context.Context, keyID string) error {
+	if err := a.client.requireWriteAccess(); err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("/api/v1/auth/api-keys/%s", keyID)
+	_, err := a.client.makeRequest(ctx, "DELETE", endpoint, nil, nil)
+	return err
+}
+
+// Rotate revokes keyID and issues a replacement with the same name and
+// permissions, returning the new plaintext key exactly once.
+func (a *APIKeysAPI) Rotate(ctx context.Context, keyID string) (*APIKeyResponse, error) {
+	if err := a.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/auth/api-keys/%s/rotate", keyID)
+	resp, err := a.client.makeRequest(ctx, "POST", endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotated APIKeyResponse
+	if err := a.client.parseResponse(resp, &rotated); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse rotated API key response: %v", err))
+	}
+	return &rotated, nil
+}
+
+// TenantsAPI groups the admin operations for managing tenants.
+type TenantsAPI struct {
+	client *Client
+}
+
+// Tenants returns the admin API for managing tenants. These calls require
+// a system_admin role server-side.
+func (c *Client) Tenants() *TenantsAPI {
+	return &TenantsAPI{client: c}
+}
+
+// Create creates a new tenant.
+func (t *TenantsAPI) Create(ctx context.Context, tenant *TenantCreate) (*TenantResponse, error) {
+	if err := t.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.makeRequest(ctx, "POST", "/api/v1/tenants", tenant, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var created TenantResponse
+	if err := t.client.parseResponse(resp, &created); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse tenant response: %v", err))
+	}
+	return &created, nil
+}
+
+// Get gets a tenant by ID.
+func (t *TenantsAPI) Get(ctx context.Context, tenantID string) (*TenantResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/tenants/%s", tenantID)
+
+	resp, err := t.client.makeRequest(ctx, "GET", endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant TenantResponse
+	if err := t.client.parseResponse(resp, &tenant); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse tenant response: %v", err))
+	}
+	return &tenant, nil
+}
+
+// List lists tenants. This requires a system_admin role server-side.
+func (t *TenantsAPI) List(ctx context.Context) ([]TenantResponse, error) {
+	resp, err := t.client.makeRequest(ctx, "GET", "/api/v1/tenants", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenants []TenantResponse
+	if err := t.client.parseResponse(resp, &tenants); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse tenants response: %v", err))
+	}
+	return tenants, nil
+}
+
+// Update updates a tenant.
+func (t *TenantsAPI) Update(ctx context.Context, tenantID string, update *TenantUpdate) (*TenantResponse, error) {
+	if err := t.client.requireWriteAccess(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/tenants/%s", tenantID)
+	resp, err := t.client.makeRequest(ctx, "PUT", endpoint, update, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated TenantResponse
+	if err := t.client.parseResponse(resp, &updated); err != nil {
+		return nil, NewValidationError(fmt.Sprintf("failed to parse tenant response: %v", err))
+	}
+	return &updated, nil
+}
+
+// Delete deletes a tenant.
+func (t *TenantsAPI) Delete(ctx context.Context, tenantID string) error {
+	if err := t.client.requireWriteAccess(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/tenants/%s", tenantID)
+	_, err := t.client.makeRequest(ctx, "DELETE", endpoint, nil, nil)
+	return err
+}
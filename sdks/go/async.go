@@ -0,0 +1,327 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls the exponential backoff used by AsyncLogger when a
+// batch flush fails.
+type RetryPolicy struct {
+	InitialDelay time.Duration
+	Multiplier   float64
+	MaxAttempts  int
+	MaxDelay     time.Duration
+}
+
+func (r RetryPolicy) delay(attempt int) time.Duration {
+	d := float64(r.InitialDelay) * pow(r.Multiplier, attempt)
+	if max := float64(r.MaxDelay); max > 0 && d > max {
+		d = max
+	}
+	// Full jitter: sleep somewhere between 0 and the computed delay.
+	return time.Duration(rand.Float64() * d)
+}
+
+func pow(base float64, exp int) float64 {
+	result := 1.0
+	for i := 0; i < exp; i++ {
+		result *= base
+	}
+	return result
+}
+
+func defaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialDelay: 500 * time.Millisecond,
+		Multiplier:   2,
+		MaxAttempts:  5,
+		MaxDelay:     30 * time.Second,
+	}
+}
+
+// AsyncOptions configures an AsyncLogger.
+type AsyncOptions struct {
+	MaxBatchSize  int
+	FlushInterval time.Duration
+	MaxInFlight   int
+	QueueCapacity int
+	SpoolDir      string
+	RetryPolicy   RetryPolicy
+	OnError       func(events []AuditLogEventCreate, err error)
+}
+
+func (o *AsyncOptions) setDefaults() {
+	if o.MaxBatchSize == 0 {
+		o.MaxBatchSize = 100
+	}
+	if o.FlushInterval == 0 {
+		o.FlushInterval = 5 * time.Second
+	}
+	if o.MaxInFlight == 0 {
+		o.MaxInFlight = 1
+	}
+	if o.QueueCapacity == 0 {
+		o.QueueCapacity = 10000
+	}
+	if o.RetryPolicy.MaxAttempts == 0 {
+		o.RetryPolicy = defaultRetryPolicy()
+	}
+}
+
+// AsyncLogger wraps a Client and lets callers fire-and-forget audit events
+// from hot request paths. Events are buffered in memory, flushed to the
+// audit service in batches, and spilled to disk when the queue is full or
+// the service is unreachable so a restart doesn't lose them.
+type AsyncLogger struct {
+	client      *Client
+	opts        AsyncOptions
+	queue       chan AuditLogEventCreate
+	sem         chan struct{}
+	wg          sync.WaitGroup
+	closeCh     chan struct{}
+	closeOnce   sync.Once
+	flushSignal chan struct{}
+}
+
+// NewAsyncLogger creates an AsyncLogger backed by client. It replays any
+// spooled batches left over from a previous run before accepting new
+// events, then starts the background flush loop.
+func NewAsyncLogger(client *Client, opts AsyncOptions) (*AsyncLogger, error) {
+	opts.setDefaults()
+
+	if opts.SpoolDir != "" {
+		if err := os.MkdirAll(opts.SpoolDir, 0o700); err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("failed to create spool dir: %v", err))
+		}
+	}
+
+	l := &AsyncLogger{
+		client:      client,
+		opts:        opts,
+		queue:       make(chan AuditLogEventCreate, opts.QueueCapacity),
+		sem:         make(chan struct{}, opts.MaxInFlight),
+		closeCh:     make(chan struct{}),
+		flushSignal: make(chan struct{}, 1),
+	}
+
+	if opts.SpoolDir != "" {
+		l.replaySpool(context.Background())
+	}
+
+	l.wg.Add(1)
+	go l.run()
+
+	return l, nil
+}
+
+// Enqueue is Log without a ctx argument, for callers that just want a
+// fire-and-forget publish call (e.g. wrapping AsyncLogger as a
+// BufferedPublisher). It never blocks and applies the same overflow
+// handling as Log.
+func (l *AsyncLogger) Enqueue(event *AuditLogEventCreate) error {
+	return l.Log(context.Background(), event)
+}
+
+// Log enqueues an event for asynchronous delivery. It never blocks: if the
+// in-memory queue is full, the event is spilled to SpoolDir (if configured),
+// or dropped and reported via OnError if no spool is configured. Spooled
+// events are replayed only when a new AsyncLogger is created over the same
+// SpoolDir; a running logger does not replay them.
+func (l *AsyncLogger) Log(ctx context.Context, event *AuditLogEventCreate) error {
+	select {
+	case l.queue <- *event:
+		if len(l.queue) >= l.opts.MaxBatchSize {
+			l.requestFlush()
+		}
+		return nil
+	default:
+	}
+
+	if l.opts.SpoolDir == "" {
+		err := NewConfigurationError("queue full and no spool_dir configured")
+		if l.opts.OnError != nil {
+			l.opts.OnError([]AuditLogEventCreate{*event}, err)
+		}
+		return err
+	}
+
+	return l.spool([]AuditLogEventCreate{*event})
+}
+
+// Flush blocks until every event currently buffered in memory has been
+// flushed to the audit service (or spooled after exhausting retries).
+func (l *AsyncLogger) Flush(ctx context.Context) error {
+	for {
+		batch := l.drainBatch()
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := l.flushBatch(ctx, batch); err != nil {
+			return err
+		}
+	}
+}
+
+// Close flushes remaining events and stops the background flush loop.
+func (l *AsyncLogger) Close(ctx context.Context) error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closeCh)
+		l.wg.Wait()
+		err = l.Flush(ctx)
+	})
+	return err
+}
+
+func (l *AsyncLogger) run() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.flushAvailable()
+		case <-l.flushSignal:
+			l.flushAvailable()
+		case <-l.closeCh:
+			return
+		}
+	}
+}
+
+// requestFlush wakes the background flush loop immediately instead of
+// waiting for the next FlushInterval tick, used once the queue has
+// accumulated a full MaxBatchSize worth of events. It never blocks: a
+// pending signal is enough to trigger a flush, so a second one is dropped.
+func (l *AsyncLogger) requestFlush() {
+	select {
+	case l.flushSignal <- struct{}{}:
+	default:
+	}
+}
+
+func (l *AsyncLogger) flushAvailable() {
+	batch := l.drainBatch()
+	if len(batch) == 0 {
+		return
+	}
+	_ = l.flushBatch(context.Background(), batch)
+}
+
+func (l *AsyncLogger) drainBatch() []AuditLogEventCreate {
+	var batch []AuditLogEventCreate
+	for len(batch) < l.opts.MaxBatchSize {
+		select {
+		case ev := <-l.queue:
+			batch = append(batch, ev)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (l *AsyncLogger) flushBatch(ctx context.Context, batch []AuditLogEventCreate) error {
+	l.sem <- struct{}{}
+	defer func() { <-l.sem }()
+
+	policy := l.opts.RetryPolicy
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		_, err := l.client.CreateEventsBatch(ctx, batch)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if !IsRetryableError(err) {
+			break
+		}
+		if attempt < policy.MaxAttempts-1 {
+			time.Sleep(policy.delay(attempt))
+		}
+	}
+
+	if l.opts.OnError != nil {
+		l.opts.OnError(batch, lastErr)
+	}
+	if l.opts.SpoolDir != "" {
+		if err := l.spool(batch); err != nil {
+			return err
+		}
+		return nil
+	}
+	return lastErr
+}
+
+// spool appends batch to a newline-delimited JSON file under SpoolDir.
+func (l *AsyncLogger) spool(batch []AuditLogEventCreate) error {
+	path := filepath.Join(l.opts.SpoolDir, fmt.Sprintf("spool-%d.ndjson", time.Now().UnixNano()))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return NewConfigurationError(fmt.Sprintf("failed to open spool file: %v", err))
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, ev := range batch {
+		if err := enc.Encode(ev); err != nil {
+			return NewConfigurationError(fmt.Sprintf("failed to spool event: %v", err))
+		}
+	}
+	return nil
+}
+
+// replaySpool reads every spool file under SpoolDir, attempts to flush its
+// contents, and removes the file on success, leaving it in place (for a
+// later retry) on failure.
+func (l *AsyncLogger) replaySpool(ctx context.Context) {
+	entries, err := os.ReadDir(l.opts.SpoolDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".ndjson" {
+			continue
+		}
+		path := filepath.Join(l.opts.SpoolDir, entry.Name())
+		batch, err := readSpoolFile(path)
+		if err != nil || len(batch) == 0 {
+			continue
+		}
+		if _, err := l.client.CreateEventsBatch(ctx, batch); err == nil {
+			os.Remove(path)
+		}
+	}
+}
+
+func readSpoolFile(path string) ([]AuditLogEventCreate, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var batch []AuditLogEventCreate
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var ev AuditLogEventCreate
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue
+		}
+		batch = append(batch, ev)
+	}
+	return batch, scanner.Err()
+}
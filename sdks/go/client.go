@@ -10,19 +10,73 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Client represents the Audit Log Framework Go SDK client
 type Client struct {
-	baseURL      string
-	apiKey       string
-	tenantID     string
-	httpClient   *http.Client
-	maxRetries   int
-	retryDelay   time.Duration
-	accessToken  string
-	refreshToken string
+	baseURL        string
+	apiKey         string
+	tenantID       string
+	httpClient     *http.Client
+	maxRetries     int
+	retryDelay     time.Duration
+	tokenMu        sync.RWMutex
+	accessToken    string
+	refreshToken   string
+	identity       *mtlsIdentity
+	cachedRole     *UserRole
+	maxRetryDelay  time.Duration
+	rateLimitMu    sync.RWMutex
+	rateLimitState RateLimitState
+	logger         Logger
+	requestHook    RequestHook
+	responseHook   ResponseHook
+
+	closeOnce      sync.Once
+	certReloadStop chan struct{}
+}
+
+// Close stops any background work started on behalf of the client, such as
+// the file-based mTLS certificate reload loop started by
+// NewClientWithMTLSFromFiles, releasing its goroutine and signal
+// registration. It is a no-op for clients that never started any.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		if c.certReloadStop != nil {
+			close(c.certReloadStop)
+		}
+	})
+	return nil
+}
+
+// RateLimitState reports the rate-limit headroom the server last reported.
+type RateLimitState struct {
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimitState returns the most recently observed rate-limit headroom, so
+// high-volume callers can proactively slow down before being throttled.
+func (c *Client) RateLimitState() RateLimitState {
+	c.rateLimitMu.RLock()
+	defer c.rateLimitMu.RUnlock()
+	return c.rateLimitState
+}
+
+func (c *Client) updateRateLimitState(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	state := RateLimitState{Remaining: remaining}
+	if resetSec, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		state.Reset = time.Unix(resetSec, 0)
+	}
+	c.rateLimitMu.Lock()
+	c.rateLimitState = state
+	c.rateLimitMu.Unlock()
 }
 
 // ClientConfig represents configuration options for the client
@@ -34,6 +88,25 @@ type ClientConfig struct {
 	MaxRetries   int
 	RetryDelay   time.Duration
 	HTTPClient   *http.Client
+	// TokenSource, if set, supplies access tokens for JWT-authenticated
+	// clients from an external provider instead of the built-in Login flow.
+	TokenSource TokenSource
+	// MaxRetryDelay clamps how long makeRequest will sleep before a retry,
+	// whether the delay came from exponential backoff or a server-supplied
+	// Retry-After header.
+	MaxRetryDelay time.Duration
+	// Logger receives structured logs for each request attempt. Defaults to
+	// a no-op logger; use NewSlogLogger or NewLogrusLogger to wire one in.
+	Logger Logger
+	// RequestHook, if set, is invoked immediately before each attempt is
+	// sent.
+	RequestHook RequestHook
+	// ResponseHook, if set, is invoked after each attempt completes.
+	ResponseHook ResponseHook
+	// TLSConfig, if set and HTTPClient is nil, builds the default
+	// transport's *tls.Config (CA bundle, client certificate, ...) instead
+	// of relying on the system defaults.
+	TLSConfig *TLSConfig
 }
 
 // NewClient creates a new Audit Log Framework client
@@ -56,22 +129,56 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if config.RetryDelay == 0 {
 		config.RetryDelay = time.Second
 	}
+	if config.MaxRetryDelay == 0 {
+		config.MaxRetryDelay = 60 * time.Second
+	}
+	if config.Logger == nil {
+		config.Logger = noopLogger{}
+	}
 
 	httpClient := config.HTTPClient
 	if httpClient == nil {
+		var transport http.RoundTripper
+		if config.TLSConfig != nil {
+			tlsConf, err := buildTLSConfig(config.TLSConfig)
+			if err != nil {
+				return nil, err
+			}
+			transport = &http.Transport{TLSClientConfig: tlsConf}
+		}
 		httpClient = &http.Client{
-			Timeout: config.Timeout,
+			Timeout:   config.Timeout,
+			Transport: transport,
 		}
 	}
 
-	return &Client{
-		baseURL:    strings.TrimSuffix(config.BaseURL, "/"),
-		apiKey:     config.APIKey,
-		tenantID:   config.TenantID,
-		httpClient: httpClient,
-		maxRetries: config.MaxRetries,
-		retryDelay: config.RetryDelay,
-	}, nil
+	client := &Client{
+		baseURL:       strings.TrimSuffix(config.BaseURL, "/"),
+		apiKey:        config.APIKey,
+		tenantID:      config.TenantID,
+		httpClient:    httpClient,
+		maxRetries:    config.MaxRetries,
+		retryDelay:    config.RetryDelay,
+		maxRetryDelay: config.MaxRetryDelay,
+		logger:        config.Logger,
+		requestHook:   config.RequestHook,
+		responseHook:  config.ResponseHook,
+	}
+
+	// Every client without an API key gets transparent refresh-on-401,
+	// mTLS clients included; an API key doesn't expire mid-session. A 401
+	// with no refresh token or TokenSource is returned unchanged.
+	if config.APIKey == "" {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		wrapped := *httpClient
+		wrapped.Transport = newAuthTransport(transport, client, config.TokenSource)
+		client.httpClient = &wrapped
+	}
+
+	return client, nil
 }
 
 // NewClientWithAPIKey creates a new client with API key authentication
@@ -117,8 +224,8 @@ func (c *Client) getHeaders() map[string]string {
 	}
 
 	// Add authentication headers
-	if c.accessToken != "" {
-		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.accessToken)
+	if accessToken := c.getAccessToken(); accessToken != "" {
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", accessToken)
 	} else if c.apiKey != "" {
 		headers["X-API-Key"] = c.apiKey
 		if c.tenantID != "" {
@@ -129,6 +236,42 @@ func (c *Client) getHeaders() map[string]string {
 	return headers
 }
 
+// getAccessToken returns the current access token. It is safe to call
+// concurrently with setAccessToken/setTokens.
+func (c *Client) getAccessToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.accessToken
+}
+
+// getRefreshToken returns the current refresh token. It is safe to call
+// concurrently with setAccessToken/setTokens.
+func (c *Client) getRefreshToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.refreshToken
+}
+
+// setAccessToken updates the access token under tokenMu so concurrent
+// requests reading it via getHeaders/getAccessToken never observe a torn
+// value. Used for transparent refreshes (e.g. authTransport) that only
+// replace the access token.
+func (c *Client) setAccessToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = token
+}
+
+// setTokens updates the access and refresh tokens together under tokenMu.
+func (c *Client) setTokens(accessToken, refreshToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = accessToken
+	if refreshToken != "" {
+		c.refreshToken = refreshToken
+	}
+}
+
 // makeRequest performs an HTTP request with retry logic
 func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body interface{}, params map[string]string) (*http.Response, error) {
 	url := c.buildURL(endpoint, params)
@@ -143,6 +286,8 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 	}
 
 	var lastErr error
+	requestID := requestIDFromContext(ctx)
+
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		// Reset body reader for retries
 		if body != nil {
@@ -159,21 +304,40 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 		for key, value := range c.getHeaders() {
 			req.Header.Set(key, value)
 		}
+		req.Header.Set("X-Request-ID", requestID)
+
+		if c.requestHook != nil {
+			c.requestHook(ctx, req)
+		}
 
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
+		duration := time.Since(start)
+
+		if c.responseHook != nil {
+			c.responseHook(ctx, req, resp, err)
+		}
+
 		if err != nil {
 			lastErr = NewNetworkError(fmt.Sprintf("request failed: %v", err), err)
-			
+			GetErrorDetails(lastErr)["request_id"] = requestID
+			c.logger.Error("audit request failed", "method", method, "url", url,
+				"attempt", attempt, "request_id", requestID, "duration", duration, "error", err)
+
 			// Check if we should retry
 			if attempt < c.maxRetries && IsRetryableError(lastErr) {
-				time.Sleep(c.retryDelay * time.Duration(1<<attempt)) // Exponential backoff
+				time.Sleep(c.retryDelayFor(lastErr, "", attempt))
 				continue
 			}
 			return nil, lastErr
 		}
 
+		c.updateRateLimitState(resp.Header)
+
 		// Check for successful response
 		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			c.logger.Debug("audit request succeeded", "method", method, "url", url,
+				"status", resp.StatusCode, "attempt", attempt, "request_id", requestID, "duration", duration)
 			return resp, nil
 		}
 
@@ -185,13 +349,18 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 				Message: fmt.Sprintf("HTTP %d: %s", resp.StatusCode, resp.Status),
 			}
 		}
+		retryAfterHeader := resp.Header.Get("Retry-After")
 		resp.Body.Close()
 
 		lastErr = CreateErrorFromResponse(resp.StatusCode, errorResp)
+		GetErrorDetails(lastErr)["request_id"] = requestID
+
+		c.logger.Warn("audit request returned an error", "method", method, "url", url,
+			"status", resp.StatusCode, "attempt", attempt, "request_id", requestID, "duration", duration)
 
 		// Check if we should retry
 		if attempt < c.maxRetries && IsRetryableError(lastErr) {
-			time.Sleep(c.retryDelay * time.Duration(1<<attempt)) // Exponential backoff
+			time.Sleep(c.retryDelayFor(lastErr, retryAfterHeader, attempt))
 			continue
 		}
 
@@ -201,6 +370,40 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 	return nil, lastErr
 }
 
+// retryDelayFor picks how long to sleep before retrying a failed attempt.
+// A server-supplied Retry-After header (seconds or an HTTP-date) or a
+// RateLimitError's RetryAfter field take priority over blind exponential
+// backoff, since the server knows its own recovery time better than we do.
+// The result is always clamped to c.maxRetryDelay.
+func (c *Client) retryDelayFor(err error, retryAfterHeader string, attempt int) time.Duration {
+	delay := c.retryDelay * time.Duration(1<<attempt)
+
+	if retryAfterHeader != "" {
+		if d, ok := parseRetryAfter(retryAfterHeader); ok {
+			delay = d
+		}
+	} else if rateLimitErr, ok := err.(*RateLimitError); ok && rateLimitErr.RetryAfter > 0 {
+		delay = time.Duration(rateLimitErr.RetryAfter) * time.Second
+	}
+
+	if c.maxRetryDelay > 0 && delay > c.maxRetryDelay {
+		delay = c.maxRetryDelay
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 7231 is
+// either a number of seconds or an HTTP-date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
+
 // parseResponse parses the response body into the target struct
 func (c *Client) parseResponse(resp *http.Response, target interface{}) error {
 	defer resp.Body.Close()
@@ -233,20 +436,20 @@ func (c *Client) Login(ctx context.Context, username, password, tenantID string)
 	}
 
 	// Store tokens
-	c.accessToken = tokenResp.AccessToken
-	c.refreshToken = tokenResp.RefreshToken
+	c.setTokens(tokenResp.AccessToken, tokenResp.RefreshToken)
 
 	return &tokenResp, nil
 }
 
 // RefreshToken refreshes the access token using the refresh token
 func (c *Client) RefreshToken(ctx context.Context) (*TokenResponse, error) {
-	if c.refreshToken == "" {
+	refreshToken := c.getRefreshToken()
+	if refreshToken == "" {
 		return nil, NewAuthenticationError("no refresh token available")
 	}
 
 	refreshReq := RefreshTokenRequest{
-		RefreshToken: c.refreshToken,
+		RefreshToken: refreshToken,
 	}
 
 	resp, err := c.makeRequest(ctx, "POST", "/api/v1/auth/refresh", refreshReq, nil)
@@ -260,10 +463,7 @@ func (c *Client) RefreshToken(ctx context.Context) (*TokenResponse, error) {
 	}
 
 	// Update tokens
-	c.accessToken = tokenResp.AccessToken
-	if tokenResp.RefreshToken != "" {
-		c.refreshToken = tokenResp.RefreshToken
-	}
+	c.setTokens(tokenResp.AccessToken, tokenResp.RefreshToken)
 
 	return &tokenResp, nil
 }
@@ -271,11 +471,13 @@ func (c *Client) RefreshToken(ctx context.Context) (*TokenResponse, error) {
 // Logout logs out the user and clears tokens
 func (c *Client) Logout(ctx context.Context) error {
 	_, err := c.makeRequest(ctx, "POST", "/api/v1/auth/logout", nil, nil)
-	
+
 	// Clear tokens regardless of response
+	c.tokenMu.Lock()
 	c.accessToken = ""
 	c.refreshToken = ""
-	
+	c.tokenMu.Unlock()
+
 	return err
 }
 
@@ -291,6 +493,10 @@ func (c *Client) GetCurrentUser(ctx context.Context) (*UserResponse, error) {
 		return nil, NewValidationError(fmt.Sprintf("failed to parse user response: %v", err))
 	}
 
+	if len(user.Roles) > 0 {
+		c.cachedRole = &user.Roles[0]
+	}
+
 	return &user, nil
 }
 
@@ -388,6 +594,9 @@ func (c *Client) QueryEvents(ctx context.Context, query *AuditLogQuery, page, si
 		if query.SortOrder != nil {
 			params["sort_order"] = *query.SortOrder
 		}
+		if query.Cursor != nil {
+			params["cursor"] = *query.Cursor
+		}
 	}
 
 	resp, err := c.makeRequest(ctx, "GET", "/api/v1/audit/events", nil, params)
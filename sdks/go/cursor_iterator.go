@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodeCursor builds the opaque keyset pagination token QueryEvents
+// accepts via AuditLogQuery.Cursor, encoding the (timestamp, event_id) pair
+// an event was returned with.
+func EncodeCursor(timestamp time.Time, eventID string) string {
+	raw := fmt.Sprintf("%d:%s", timestamp.UnixNano(), eventID)
+	return base64.URLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor reverses EncodeCursor.
+func DecodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", NewValidationError(fmt.Sprintf("invalid cursor: %v", err))
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", NewValidationError("invalid cursor: malformed payload")
+	}
+	ns, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", NewValidationError(fmt.Sprintf("invalid cursor timestamp: %v", err))
+	}
+	return time.Unix(0, ns), parts[1], nil
+}
+
+type cursorPage struct {
+	events []AuditLogEvent
+	err    error
+}
+
+// CursorIterator walks QueryEvents using keyset pagination (AuditLogQuery.
+// Cursor) rather than page/size offsets, so results stay consistent even as
+// new events arrive mid-iteration. It prefetches the next page while the
+// caller processes the current one.
+type CursorIterator struct {
+	cancel context.CancelFunc
+	pages  chan cursorPage
+
+	buf        []AuditLogEvent
+	idx        int
+	current    *AuditLogEvent
+	lastCursor string
+	err        error
+}
+
+// IterateEvents returns a CursorIterator over every event matching query,
+// fetching pageSize events per page. Use it.Cursor() after a crash to
+// resume an export by setting AuditLogQuery.Cursor to the returned value.
+func (c *Client) IterateEvents(ctx context.Context, query *AuditLogQuery, pageSize int) *CursorIterator {
+	ctx, cancel := context.WithCancel(ctx)
+	it := &CursorIterator{
+		cancel: cancel,
+		pages:  make(chan cursorPage, 1),
+	}
+
+	q := AuditLogQuery{}
+	if query != nil {
+		q = *query
+	}
+
+	go it.fetchLoop(ctx, c, q, pageSize)
+	return it
+}
+
+func (it *CursorIterator) fetchLoop(ctx context.Context, c *Client, query AuditLogQuery, pageSize int) {
+	defer close(it.pages)
+
+	for {
+		results, err := c.QueryEvents(ctx, &query, 1, pageSize)
+		if err != nil {
+			select {
+			case it.pages <- cursorPage{err: err}:
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		select {
+		case it.pages <- cursorPage{events: results.Items}:
+		case <-ctx.Done():
+			return
+		}
+
+		if len(results.Items) < pageSize || results.NextCursor == nil {
+			return
+		}
+		query.Cursor = results.NextCursor
+	}
+}
+
+// Next advances the iterator. It returns false at the end of the result set
+// or on error; check Err afterwards to distinguish the two.
+func (it *CursorIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.idx >= len(it.buf) {
+		page, ok := <-it.pages
+		if !ok {
+			return false
+		}
+		if page.err != nil {
+			it.err = page.err
+			return false
+		}
+		it.buf = page.events
+		it.idx = 0
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+
+	it.current = &it.buf[it.idx]
+	it.lastCursor = EncodeCursor(it.current.Timestamp, it.current.ID)
+	it.idx++
+	return true
+}
+
+// Event returns the event produced by the most recent call to Next.
+func (it *CursorIterator) Event() *AuditLogEvent {
+	return it.current
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *CursorIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the resumption token for the last event delivered by Next.
+func (it *CursorIterator) Cursor() string {
+	return it.lastCursor
+}
+
+// Close stops prefetching. Safe to call even if the iterator was already
+// drained.
+func (it *CursorIterator) Close() {
+	it.cancel()
+}
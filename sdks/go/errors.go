@@ -258,7 +258,7 @@ func CreateErrorFromResponse(statusCode int, errorResponse *ErrorResponse) error
 // IsRetryableError checks if an error is retryable
 func IsRetryableError(err error) bool {
 	switch err.(type) {
-	case *NetworkError, *TimeoutError, *ServerError:
+	case *NetworkError, *TimeoutError, *ServerError, *RateLimitError:
 		return true
 	case *AuditError:
 		auditErr := err.(*AuditError)
@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// TokenSource supplies access tokens from an external provider (an OIDC
+// client-credentials flow, Vault, ...) instead of the SDK's built-in
+// Login/RefreshToken flow. Token is called whenever authTransport needs a
+// fresh token after a 401.
+type TokenSource interface {
+	Token(ctx context.Context) (accessToken string, err error)
+}
+
+// authTransport wraps the client's configured transport, injects the
+// current auth headers on every request, and on a 401 transparently
+// refreshes the access token (via TokenSource if set, otherwise
+// Client.RefreshToken) and retries the original request exactly once.
+// Concurrent requests that hit a 401 together share a single refresh via
+// refreshMu/refreshing, so a token expiring under load doesn't cause a
+// stampede of refresh calls.
+type authTransport struct {
+	next        http.RoundTripper
+	client      *Client
+	tokenSource TokenSource
+
+	refreshMu  sync.Mutex
+	refreshing chan struct{}
+}
+
+func newAuthTransport(next http.RoundTripper, client *Client, tokenSource TokenSource) *authTransport {
+	return &authTransport{next: next, client: client, tokenSource: tokenSource}
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.applyHeaders(req)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	if req.Body != nil && req.GetBody == nil {
+		// Can't safely replay a body-bearing request; surface the 401.
+		return resp, nil
+	}
+
+	if refreshErr := t.refresh(req.Context()); refreshErr != nil {
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	t.applyHeaders(retry)
+
+	return t.next.RoundTrip(retry)
+}
+
+func (t *authTransport) applyHeaders(req *http.Request) {
+	for key, value := range t.client.getHeaders() {
+		req.Header.Set(key, value)
+	}
+}
+
+// refresh performs a single-flight token refresh: if a refresh is already
+// in progress, callers wait for it instead of starting their own.
+func (t *authTransport) refresh(ctx context.Context) error {
+	t.refreshMu.Lock()
+	if t.refreshing != nil {
+		ch := t.refreshing
+		t.refreshMu.Unlock()
+		<-ch
+		return nil
+	}
+	ch := make(chan struct{})
+	t.refreshing = ch
+	t.refreshMu.Unlock()
+
+	var err error
+	if t.tokenSource != nil {
+		var token string
+		token, err = t.tokenSource.Token(ctx)
+		if err == nil {
+			t.client.setAccessToken(token)
+		}
+	} else {
+		_, err = t.client.RefreshToken(ctx)
+	}
+
+	t.refreshMu.Lock()
+	t.refreshing = nil
+	t.refreshMu.Unlock()
+	close(ch)
+
+	return err
+}
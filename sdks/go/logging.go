@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+)
+
+// Logger is the structured logging interface makeRequest logs through.
+// Implementations receive alternating key/value pairs, mirroring the
+// log/slog and logrus calling convention so adapting either is a thin
+// wrapper.
+type Logger interface {
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+
+// RequestHook is invoked immediately before a request is sent, letting
+// callers wire in OpenTelemetry spans or similar.
+type RequestHook func(ctx context.Context, req *http.Request)
+
+// ResponseHook is invoked after a request completes (successfully or not),
+// once per attempt.
+type ResponseHook func(ctx context.Context, req *http.Request, resp *http.Response, err error)
+
+// slogLogger adapts a *slog.Logger to the Logger interface.
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// NewSlogLogger adapts l to Logger.
+func NewSlogLogger(l *slog.Logger) Logger {
+	return &slogLogger{l: l}
+}
+
+func (s *slogLogger) Debug(msg string, kv ...interface{}) { s.l.Debug(msg, kv...) }
+func (s *slogLogger) Info(msg string, kv ...interface{})  { s.l.Info(msg, kv...) }
+func (s *slogLogger) Warn(msg string, kv ...interface{})  { s.l.Warn(msg, kv...) }
+func (s *slogLogger) Error(msg string, kv ...interface{}) { s.l.Error(msg, kv...) }
+
+// LogrusFieldLogger matches the subset of logrus.FieldLogger that
+// logrusLogger needs, so this package doesn't have to depend on logrus
+// directly. *logrus.Logger and *logrus.Entry do not satisfy it as they are,
+// since their WithFields returns *logrus.Entry; wrap them in a small shim.
+type LogrusFieldLogger interface {
+	WithFields(fields map[string]interface{}) LogrusFieldLogger
+	Debug(args ...interface{})
+	Info(args ...interface{})
+	Warn(args ...interface{})
+	Error(args ...interface{})
+}
+
+type logrusLogger struct {
+	l LogrusFieldLogger
+}
+
+// NewLogrusLogger adapts l to Logger. l typically wraps *logrus.Logger via
+// a small shim, since logrus.Logger.WithFields returns *logrus.Entry rather
+// than LogrusFieldLogger directly.
+func NewLogrusLogger(l LogrusFieldLogger) Logger {
+	return &logrusLogger{l: l}
+}
+
+func kvToFields(kv []interface{}) map[string]interface{} {
+	fields := make(map[string]interface{}, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			continue
+		}
+		fields[key] = kv[i+1]
+	}
+	return fields
+}
+
+func (r *logrusLogger) Debug(msg string, kv ...interface{}) {
+	r.l.WithFields(kvToFields(kv)).Debug(msg)
+}
+func (r *logrusLogger) Info(msg string, kv ...interface{}) {
+	r.l.WithFields(kvToFields(kv)).Info(msg)
+}
+func (r *logrusLogger) Warn(msg string, kv ...interface{}) {
+	r.l.WithFields(kvToFields(kv)).Warn(msg)
+}
+func (r *logrusLogger) Error(msg string, kv ...interface{}) {
+	r.l.WithFields(kvToFields(kv)).Error(msg)
+}
+
+// generateRequestID returns a random per-request correlation ID used for
+// the X-Request-ID header when ctx doesn't already carry one.
+func generateRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a ctx that makeRequest will use for the
+// X-Request-ID header instead of generating a new one, so callers can
+// correlate a request with their own tracing IDs.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	return generateRequestID()
+}
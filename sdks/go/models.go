@@ -92,6 +92,9 @@ type AuditLogQuery struct {
 	Search         *string     `json:"search,omitempty"`
 	SortBy         *string     `json:"sort_by,omitempty"`
 	SortOrder      *string     `json:"sort_order,omitempty"`
+	// Cursor, if set, requests keyset pagination starting immediately after
+	// the opaque (timestamp, event_id) token instead of offset pagination.
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 // PaginatedAuditLogs represents a paginated response of audit logs
@@ -101,6 +104,10 @@ type PaginatedAuditLogs struct {
 	Page  int             `json:"page"`
 	Size  int             `json:"size"`
 	Pages int             `json:"pages"`
+	// NextCursor/PrevCursor are opaque keyset pagination tokens, populated
+	// when the request used AuditLogQuery.Cursor instead of page/size.
+	NextCursor *string `json:"next_cursor,omitempty"`
+	PrevCursor *string `json:"prev_cursor,omitempty"`
 }
 
 // AuditLogSummary represents summary statistics for audit logs
@@ -198,6 +205,29 @@ type APIKeyResponse struct {
 	Key         *string    `json:"key,omitempty"` // Only included when creating
 }
 
+// TenantCreate represents tenant creation data
+type TenantCreate struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	IsActive bool   `json:"is_active"`
+}
+
+// TenantUpdate represents tenant update data
+type TenantUpdate struct {
+	Name     *string `json:"name,omitempty"`
+	IsActive *bool   `json:"is_active,omitempty"`
+}
+
+// TenantResponse represents a tenant response
+type TenantResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // ErrorResponse represents an API error response
 type ErrorResponse struct {
 	Error   string                 `json:"error"`
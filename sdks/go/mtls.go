@@ -0,0 +1,246 @@
+package audit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// subjectAltNamesOf extracts every Subject Alternative Name type that crypto/x509
+// exposes on a leaf certificate (DNS, IP, URI, and email), stringified so
+// callers get a single flat list regardless of SAN type.
+func subjectAltNamesOf(leaf *x509.Certificate) []string {
+	sans := append([]string{}, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range leaf.URIs {
+		sans = append(sans, uri.String())
+	}
+	sans = append(sans, leaf.EmailAddresses...)
+	return sans
+}
+
+// mtlsIdentity holds the client certificate used for mutual TLS
+// authentication along with the parsed identity fields callers care about.
+// It is reloaded in place so in-flight requests always see either the old
+// or the new certificate, never a torn one.
+type mtlsIdentity struct {
+	mu   sync.RWMutex
+	cert tls.Certificate
+	cn   string
+	sans []string
+}
+
+func (i *mtlsIdentity) get() (*tls.Certificate, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	cert := i.cert
+	return &cert, nil
+}
+
+func (i *mtlsIdentity) set(cert tls.Certificate) error {
+	leaf := cert.Leaf
+	if leaf == nil {
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return NewConfigurationError(fmt.Sprintf("failed to parse client certificate: %v", err))
+		}
+		leaf = parsed
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.cert = cert
+	i.cn = leaf.Subject.CommonName
+	i.sans = subjectAltNamesOf(leaf)
+	return nil
+}
+
+func (i *mtlsIdentity) commonName() string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.cn
+}
+
+func (i *mtlsIdentity) subjectAltNames() []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return append([]string{}, i.sans...)
+}
+
+// CertificateCommonName returns the Subject CN of the client certificate the
+// Client is currently authenticating with. It returns an empty string for
+// clients that were not created with one of the mTLS constructors.
+func (c *Client) CertificateCommonName() string {
+	if c.identity == nil {
+		return ""
+	}
+	return c.identity.commonName()
+}
+
+// CertificateSANs returns the SubjectAltNames (DNS, IP, URI, and email) of
+// the client certificate the Client is currently authenticating with.
+func (c *Client) CertificateSANs() []string {
+	if c.identity == nil {
+		return nil
+	}
+	return c.identity.subjectAltNames()
+}
+
+// buildMTLSHTTPClient constructs an *http.Client whose transport presents
+// the given client certificate and trusts caBundle, reloading the
+// certificate from identity on every handshake so rotation takes effect
+// without reconstructing the client.
+func buildMTLSHTTPClient(identity *mtlsIdentity, caBundle []byte, timeout time.Duration) (*http.Client, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caBundle) {
+		return nil, NewConfigurationError("failed to parse CA bundle")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return identity.get()
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// NewClientWithMTLS creates a new client authenticated with a mutual TLS
+// client certificate supplied in memory (PEM-encoded). This is the natural
+// fit for machine-to-machine ingestion, e.g. agents or collectors shipping
+// audit events to a central service with client certs instead of API keys.
+func NewClientWithMTLS(baseURL string, clientCert, clientKey, caBundle []byte, tenantID string) (*Client, error) {
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, NewConfigurationError(fmt.Sprintf("failed to load client certificate: %v", err))
+	}
+
+	identity := &mtlsIdentity{}
+	if err := identity.set(cert); err != nil {
+		return nil, err
+	}
+
+	httpClient, err := buildMTLSHTTPClient(identity, caBundle, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:    baseURL,
+		TenantID:   tenantID,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.identity = identity
+	return client, nil
+}
+
+// MTLSOptions configures optional behavior of the file-based mTLS
+// constructors, such as background certificate reloading.
+type MTLSOptions struct {
+	// ReloadInterval, if non-zero, re-reads the certificate and key from
+	// disk on this interval in addition to on SIGHUP.
+	ReloadInterval time.Duration
+}
+
+// NewClientWithMTLSFromFiles is a convenience wrapper around
+// NewClientWithMTLS that reads the client certificate, key, and CA bundle
+// from disk. If opts.ReloadInterval is non-zero, or when the process
+// receives SIGHUP, the certificate and key are re-read from certFile/keyFile
+// so long-running agents can rotate credentials without a restart.
+func NewClientWithMTLSFromFiles(baseURL, certFile, keyFile, caFile, tenantID string, opts *MTLSOptions) (*Client, error) {
+	caBundle, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, NewConfigurationError(fmt.Sprintf("failed to read CA bundle: %v", err))
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, NewConfigurationError(fmt.Sprintf("failed to load client certificate: %v", err))
+	}
+
+	identity := &mtlsIdentity{}
+	if err := identity.set(cert); err != nil {
+		return nil, err
+	}
+
+	httpClient, err := buildMTLSHTTPClient(identity, caBundle, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:    baseURL,
+		TenantID:   tenantID,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.identity = identity
+	client.startCertReload(certFile, keyFile, opts)
+	return client, nil
+}
+
+// startCertReload watches for SIGHUP and, if configured, a reload interval,
+// reloading the client certificate/key pair from disk on either trigger
+// until the client is closed via Close.
+func (c *Client) startCertReload(certFile, keyFile string, opts *MTLSOptions) {
+	reload := func() {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			// Keep serving with the previous certificate; a bad rotation
+			// shouldn't take down a running agent.
+			return
+		}
+		_ = c.identity.set(cert)
+	}
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	var ticker *time.Ticker
+	var tickC <-chan time.Time
+	if opts != nil && opts.ReloadInterval > 0 {
+		ticker = time.NewTicker(opts.ReloadInterval)
+		tickC = ticker.C
+	}
+
+	c.certReloadStop = make(chan struct{})
+
+	go func() {
+		defer signal.Stop(sighup)
+		if ticker != nil {
+			defer ticker.Stop()
+		}
+		for {
+			select {
+			case <-sighup:
+				reload()
+			case <-tickC:
+				reload()
+			case <-c.certReloadStop:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,144 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed standard 5-field cron expression:
+// minute hour day-of-month month day-of-week.
+type cronSchedule struct {
+	minute  map[int]bool
+	hour    map[int]bool
+	dom     map[int]bool
+	month   map[int]bool
+	dow     map[int]bool
+
+	// domStar and dowStar record whether the day-of-month/day-of-week
+	// fields were "*" in the original spec. Standard (Vixie) cron ORs
+	// dom and dow when both are restricted, rather than ANDing them.
+	domStar bool
+	dowStar bool
+}
+
+func parseCron(spec string) (*cronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule: cron spec %q must have 5 fields, got %d", spec, len(fields))
+	}
+
+	minute, err := parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: minute field: %w", err)
+	}
+	hour, err := parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: hour field: %w", err)
+	}
+	dom, err := parseCronField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: day-of-month field: %w", err)
+	}
+	month, err := parseCronField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: month field: %w", err)
+	}
+	dow, err := parseCronField(fields[4], 0, 6)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: day-of-week field: %w", err)
+	}
+
+	return &cronSchedule{
+		minute:  minute,
+		hour:    hour,
+		dom:     dom,
+		month:   month,
+		dow:     dow,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	result := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		rangePart := part
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangePart = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = s
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			if dash := strings.Index(rangePart, "-"); dash >= 0 {
+				l, err1 := strconv.Atoi(rangePart[:dash])
+				h, err2 := strconv.Atoi(rangePart[dash+1:])
+				if err1 != nil || err2 != nil {
+					return nil, fmt.Errorf("invalid range %q", rangePart)
+				}
+				lo, hi = l, h
+			} else {
+				v, err := strconv.Atoi(rangePart)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q", rangePart)
+				}
+				lo, hi = v, v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			if v < min || v > max {
+				return nil, fmt.Errorf("value %d out of range [%d,%d]", v, min, max)
+			}
+			result[v] = true
+		}
+	}
+
+	return result, nil
+}
+
+func (c *cronSchedule) matches(t time.Time) bool {
+	return c.minute[t.Minute()] &&
+		c.hour[t.Hour()] &&
+		c.month[int(t.Month())] &&
+		c.dayMatches(t)
+}
+
+// dayMatches implements the standard cron rule for combining day-of-month
+// and day-of-week: when both fields are restricted (neither is "*"), a day
+// matches if EITHER field matches, not both. When only one is restricted,
+// it alone constrains the day.
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	switch {
+	case c.domStar && c.dowStar:
+		return true
+	case c.domStar:
+		return c.dow[int(t.Weekday())]
+	case c.dowStar:
+		return c.dom[t.Day()]
+	default:
+		return c.dom[t.Day()] || c.dow[int(t.Weekday())]
+	}
+}
+
+// next returns the next time strictly after `after` that satisfies the
+// schedule, searching minute-by-minute up to two years out.
+func (c *cronSchedule) next(after time.Time) (time.Time, error) {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.AddDate(2, 0, 0)
+	for t.Before(limit) {
+		if c.matches(t) {
+			return t, nil
+		}
+		t = t.Add(time.Minute)
+	}
+	return time.Time{}, fmt.Errorf("schedule: no matching time found within 2 years")
+}
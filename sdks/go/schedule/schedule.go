@@ -0,0 +1,231 @@
+// Package schedule lets a Go SDK client register recurring export jobs
+// against the audit service, each running on a cron expression and
+// shipping results to a pluggable Sink (local file, S3-compatible object
+// storage, webhook, ...). This mirrors how replication policies in
+// mirror-style services are configured as cron + target + policy triples,
+// giving compliance teams turnkey scheduled log shipping instead of
+// building it in every consumer.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	audit "github.com/yourcompany/audit-service/sdk"
+)
+
+// Sink receives the exported bytes for a single job run.
+type Sink interface {
+	Write(ctx context.Context, r io.Reader) error
+}
+
+// Job describes a recurring export.
+type Job struct {
+	Name     string
+	CronSpec string
+	Query    *audit.AuditLogQuery
+	Format   string
+	Sink     Sink
+}
+
+// JobStatus reports the last run outcome for a job.
+type JobStatus struct {
+	LastSuccess time.Time
+	LastError   error
+	LastRunAt   time.Time
+}
+
+type jobState struct {
+	job      Job
+	schedule *cronSchedule
+	status   JobStatus
+}
+
+// Scheduler runs a set of Jobs on their cron schedules, persisting a
+// per-job checkpoint of the last exported timestamp so re-runs after a
+// restart are incremental.
+type Scheduler struct {
+	client         *audit.Client
+	checkpointPath string
+
+	mu   sync.Mutex
+	jobs map[string]*jobState
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewScheduler creates a Scheduler that issues queries through client. If
+// checkpointPath is non-empty, checkpoints are persisted there as JSON and
+// re-read at the start of every job run, so a restarted process resumes
+// incrementally.
+func NewScheduler(client *audit.Client, checkpointPath string) *Scheduler {
+	s := &Scheduler{
+		client:         client,
+		checkpointPath: checkpointPath,
+		jobs:           make(map[string]*jobState),
+		stopCh:         make(chan struct{}),
+		doneCh:         make(chan struct{}),
+	}
+	return s
+}
+
+// AddJob registers a job. It is an error to register two jobs with the same
+// Name or a job whose CronSpec doesn't parse.
+func (s *Scheduler) AddJob(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("schedule: job name is required")
+	}
+	sched, err := parseCron(job.CronSpec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.jobs[job.Name]; exists {
+		return fmt.Errorf("schedule: job %q already registered", job.Name)
+	}
+	s.jobs[job.Name] = &jobState{job: job, schedule: sched}
+	return nil
+}
+
+// Status returns the last known status of job name.
+func (s *Scheduler) Status(name string) (JobStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	js, ok := s.jobs[name]
+	if !ok {
+		return JobStatus{}, false
+	}
+	return js.status, true
+}
+
+// Run blocks, evaluating every registered job once a minute and firing the
+// ones whose cron schedule matches, until ctx is cancelled or Stop is
+// called.
+func (s *Scheduler) Run(ctx context.Context) error {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		}
+	}
+}
+
+// Stop signals Run to return and waits for it to do so.
+func (s *Scheduler) Stop() {
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+	<-s.doneCh
+}
+
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	due := make([]*jobState, 0)
+	for _, js := range s.jobs {
+		if js.schedule.matches(now) {
+			due = append(due, js)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, js := range due {
+		s.runJob(ctx, js, now)
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context, js *jobState, runAt time.Time) {
+	checkpoints := loadCheckpoints(s.checkpointPath)
+
+	query := js.job.Query
+	if query == nil {
+		query = &audit.AuditLogQuery{}
+	}
+	cp := *query
+	if last, ok := checkpoints[js.job.Name]; ok {
+		start := last
+		cp.StartDate = &start
+	}
+
+	pr, pw := io.Pipe()
+	var exportErr error
+	var lastEventAt time.Time
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, exportErr = s.client.ExportEventsStreamFunc(ctx, &cp, js.job.Format, pw, func(ev *audit.AuditLogEvent) {
+			if ev.Timestamp.After(lastEventAt) {
+				lastEventAt = ev.Timestamp
+			}
+		})
+		pw.Close()
+	}()
+
+	sinkErr := js.job.Sink.Write(ctx, pr)
+	// The sink may return before draining pr (e.g. on its own error), in
+	// which case the export goroutine's pw.Write would block forever.
+	// Closing pr with an error unblocks it so <-done always returns.
+	pr.CloseWithError(io.ErrClosedPipe)
+	<-done
+
+	s.mu.Lock()
+	js.status.LastRunAt = runAt
+	if exportErr != nil {
+		js.status.LastError = exportErr
+	} else if sinkErr != nil {
+		js.status.LastError = sinkErr
+	} else {
+		js.status.LastError = nil
+		js.status.LastSuccess = runAt
+		// Checkpoint the last exported event's own timestamp, not the
+		// wall-clock tick time: events between it and runAt that the
+		// query didn't see would otherwise be silently skipped next run.
+		if !lastEventAt.IsZero() {
+			checkpoints[js.job.Name] = lastEventAt
+			saveCheckpoints(s.checkpointPath, checkpoints)
+		}
+	}
+	s.mu.Unlock()
+}
+
+func loadCheckpoints(path string) map[string]time.Time {
+	checkpoints := make(map[string]time.Time)
+	if path == "" {
+		return checkpoints
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return checkpoints
+	}
+	_ = json.Unmarshal(data, &checkpoints)
+	return checkpoints
+}
+
+func saveCheckpoints(path string, checkpoints map[string]time.Time) {
+	if path == "" {
+		return
+	}
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o600)
+}
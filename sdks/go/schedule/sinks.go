@@ -0,0 +1,104 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileSink writes each job run to a local file, rotating the previous
+// file aside (suffixed with the current time in Unix nanoseconds) if one
+// already exists.
+type FileSink struct {
+	Path string
+}
+
+// Write implements Sink.
+func (f *FileSink) Write(ctx context.Context, r io.Reader) error {
+	if _, err := os.Stat(f.Path); err == nil {
+		rotated := fmt.Sprintf("%s.%d", f.Path, time.Now().UnixNano())
+		if err := os.Rename(f.Path, rotated); err != nil {
+			return fmt.Errorf("schedule: failed to rotate %s: %w", f.Path, err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o755); err != nil {
+		return fmt.Errorf("schedule: failed to create export dir: %w", err)
+	}
+
+	out, err := os.Create(f.Path)
+	if err != nil {
+		return fmt.Errorf("schedule: failed to create export file: %w", err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, r); err != nil {
+		return fmt.Errorf("schedule: failed to write export file: %w", err)
+	}
+	return nil
+}
+
+// S3PutObject matches the single method of the AWS SDK's s3.Client that
+// S3Sink needs, so callers can pass in their own configured client without
+// this package depending on the AWS SDK directly.
+type S3PutObject interface {
+	PutObject(ctx context.Context, bucket, key string, body io.Reader) error
+}
+
+// S3Sink writes each job run as an object in S3-compatible storage under
+// Prefix/<unix-nanoseconds>.export; the key does not reflect the job's
+// Format.
+type S3Sink struct {
+	Client S3PutObject
+	Bucket string
+	Prefix string
+}
+
+// Write implements Sink.
+func (s *S3Sink) Write(ctx context.Context, r io.Reader) error {
+	key := fmt.Sprintf("%s/%d.export", s.Prefix, time.Now().UnixNano())
+	if err := s.Client.PutObject(ctx, s.Bucket, key, r); err != nil {
+		return fmt.Errorf("schedule: failed to upload export to s3://%s/%s: %w", s.Bucket, key, err)
+	}
+	return nil
+}
+
+// WebhookSink POSTs each job run's export body to a URL.
+type WebhookSink struct {
+	URL         string
+	ContentType string
+	HTTPClient  *http.Client
+}
+
+// Write implements Sink.
+func (w *WebhookSink) Write(ctx context.Context, r io.Reader) error {
+	client := w.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	contentType := w.ContentType
+	if contentType == "" {
+		contentType = "application/x-ndjson"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, r)
+	if err != nil {
+		return fmt.Errorf("schedule: failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("schedule: webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("schedule: webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const defaultStreamPageSize = 100
+
+// EventIterator walks the pages returned by QueryEvents and yields events
+// one at a time, so callers processing large result sets don't have to
+// hand-roll their own page/size loop.
+type EventIterator struct {
+	client   *Client
+	ctx      context.Context
+	query    *AuditLogQuery
+	pageSize int
+
+	page    int
+	buf     []AuditLogEvent
+	idx     int
+	current *AuditLogEvent
+	done    bool
+	err     error
+}
+
+// StreamEvents returns an EventIterator over every event matching query,
+// fetching pages transparently as the caller advances.
+func (c *Client) StreamEvents(ctx context.Context, query *AuditLogQuery) *EventIterator {
+	return &EventIterator{
+		client:   c,
+		ctx:      ctx,
+		query:    query,
+		pageSize: defaultStreamPageSize,
+		page:     1,
+	}
+}
+
+// Next advances the iterator and reports whether a further event is
+// available via Event. It returns false at the end of the result set or on
+// error; callers should check Err afterwards to distinguish the two.
+func (it *EventIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.idx < len(it.buf) {
+		it.current = &it.buf[it.idx]
+		it.idx++
+		return true
+	}
+
+	if it.done {
+		return false
+	}
+
+	results, err := it.client.QueryEvents(it.ctx, it.query, it.page, it.pageSize)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.buf = results.Items
+	it.idx = 0
+	it.page++
+	if len(results.Items) < it.pageSize {
+		it.done = true
+	}
+
+	if len(it.buf) == 0 {
+		return false
+	}
+
+	it.current = &it.buf[0]
+	it.idx = 1
+	return true
+}
+
+// Event returns the event produced by the most recent call to Next.
+func (it *EventIterator) Event() *AuditLogEvent {
+	return it.current
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *EventIterator) Err() error {
+	return it.err
+}
+
+// ExportEncoder writes a stream of audit events to an underlying io.Writer
+// in a specific wire format (NDJSON, CSV, Parquet, ...).
+type ExportEncoder interface {
+	Encode(event *AuditLogEvent) error
+	Close() error
+}
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]func(io.Writer) ExportEncoder{
+		"ndjson": newNDJSONEncoder,
+		"csv":    newCSVEncoder,
+	}
+)
+
+// RegisterExportFormat registers a factory for a named export format (e.g.
+// "parquet") so it can be used with ExportEventsStream. Registering a name
+// that already exists replaces its factory.
+func RegisterExportFormat(name string, factory func(io.Writer) ExportEncoder) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	formats[name] = factory
+}
+
+func exportEncoderFor(format string, w io.Writer) (ExportEncoder, error) {
+	formatsMu.RLock()
+	factory, ok := formats[format]
+	formatsMu.RUnlock()
+	if !ok {
+		return nil, NewValidationError(fmt.Sprintf("unsupported export format: %s", format))
+	}
+	return factory(w), nil
+}
+
+// ExportEventsStream walks query via StreamEvents and writes each event to
+// w as it arrives, encoded per format, instead of buffering the entire
+// result set in memory like ExportEvents does. It returns the number of
+// events written.
+func (c *Client) ExportEventsStream(ctx context.Context, query *AuditLogQuery, format string, w io.Writer) (int64, error) {
+	return c.ExportEventsStreamFunc(ctx, query, format, w, nil)
+}
+
+// ExportEventsStreamFunc behaves like ExportEventsStream but additionally
+// invokes onEvent for every event as it is encoded, so callers that need to
+// track export progress (e.g. the schedule package checkpointing the last
+// exported event's timestamp) don't have to duplicate the iterator/encoder
+// plumbing. onEvent may be nil.
+func (c *Client) ExportEventsStreamFunc(ctx context.Context, query *AuditLogQuery, format string, w io.Writer, onEvent func(*AuditLogEvent)) (int64, error) {
+	encoder, err := exportEncoderFor(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	it := c.StreamEvents(ctx, query)
+	for it.Next() {
+		ev := it.Event()
+		if err := encoder.Encode(ev); err != nil {
+			return count, NewValidationError(fmt.Sprintf("failed to encode event: %v", err))
+		}
+		if onEvent != nil {
+			onEvent(ev)
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		return count, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return count, NewValidationError(fmt.Sprintf("failed to finalize export: %v", err))
+	}
+	return count, nil
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) ExportEncoder {
+	return &ndjsonEncoder{enc: json.NewEncoder(w)}
+}
+
+func (e *ndjsonEncoder) Encode(event *AuditLogEvent) error {
+	return e.enc.Encode(event)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer) ExportEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) Encode(event *AuditLogEvent) error {
+	if !e.wroteHeader {
+		if err := e.w.Write([]string{
+			"id", "tenant_id", "event_type", "resource_type", "action",
+			"severity", "description", "timestamp", "user_id", "resource_id",
+		}); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+
+	var userID, resourceID string
+	if event.UserID != nil {
+		userID = *event.UserID
+	}
+	if event.ResourceID != nil {
+		resourceID = *event.ResourceID
+	}
+
+	return e.w.Write([]string{
+		event.ID,
+		event.TenantID,
+		string(event.EventType),
+		event.ResourceType,
+		event.Action,
+		string(event.Severity),
+		event.Description,
+		event.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+		userID,
+		resourceID,
+	})
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
@@ -0,0 +1,282 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Subscription delivers audit log events matching a query as they are
+// created by the server, instead of the caller polling QueryEvents in a
+// loop.
+type Subscription struct {
+	events chan *AuditLogEvent
+	errors chan error
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Events returns the channel of events delivered to this subscription. It
+// is closed when the subscription is closed or its context is cancelled.
+func (s *Subscription) Events() <-chan *AuditLogEvent {
+	return s.events
+}
+
+// Errors returns the channel of non-fatal errors encountered while
+// streaming (e.g. a dropped connection before a successful reconnect).
+func (s *Subscription) Errors() <-chan error {
+	return s.errors
+}
+
+// Close stops the subscription and releases its background goroutine.
+func (s *Subscription) Close() {
+	s.cancel()
+	<-s.done
+}
+
+// Subscribe opens a live stream of events matching query, honoring the same
+// filters QueryEvents supports so the server only forwards matching events.
+// It is backed by Server-Sent Events and falls back to long-polling
+// QueryEvents if the server doesn't support streaming for this request.
+func (c *Client) Subscribe(ctx context.Context, query *AuditLogQuery) (*Subscription, error) {
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &Subscription{
+		events: make(chan *AuditLogEvent, 64),
+		errors: make(chan error, 8),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go c.runSubscription(subCtx, query, sub)
+	return sub, nil
+}
+
+// SubscribeEvents is Subscribe with its channels returned directly instead
+// of wrapped in a Subscription, for callers that don't need an explicit
+// Close and are happy to stop by cancelling ctx.
+func (c *Client) SubscribeEvents(ctx context.Context, query *AuditLogQuery) (<-chan *AuditLogEvent, <-chan error, error) {
+	sub, err := c.Subscribe(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub.Events(), sub.Errors(), nil
+}
+
+func (c *Client) runSubscription(ctx context.Context, query *AuditLogQuery, sub *Subscription) {
+	defer close(sub.done)
+	defer close(sub.events)
+	defer close(sub.errors)
+
+	lastEventID := ""
+	attempt := 0
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		resp, err := c.openStream(ctx, query, lastEventID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case sub.errors <- err:
+			case <-ctx.Done():
+				return
+			}
+			if !sleepBackoff(ctx, attempt) {
+				return
+			}
+			attempt++
+			continue
+		}
+		attempt = 0
+
+		if !isEventStream(resp) {
+			resp.Body.Close()
+			c.longPollFallback(ctx, query, sub)
+			return
+		}
+
+		lastEventID = c.consumeStream(ctx, resp, sub, lastEventID)
+		resp.Body.Close()
+
+		if ctx.Err() != nil {
+			return
+		}
+		if !sleepBackoff(ctx, attempt) {
+			return
+		}
+		attempt++
+	}
+}
+
+func isEventStream(resp *http.Response) bool {
+	return strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream")
+}
+
+func (c *Client) openStream(ctx context.Context, query *AuditLogQuery, lastEventID string) (*http.Response, error) {
+	endpoint := "/api/v1/audit/events/stream"
+	params := queryParams(query)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.buildURL(endpoint, params), nil)
+	if err != nil {
+		return nil, NewNetworkError(fmt.Sprintf("failed to build subscribe request: %v", err), err)
+	}
+	for key, value := range c.getHeaders() {
+		req.Header.Set(key, value)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, NewNetworkError(fmt.Sprintf("subscribe request failed: %v", err), err)
+	}
+	if resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, NewServerError(fmt.Sprintf("subscribe request returned status %d", resp.StatusCode), resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// consumeStream reads SSE frames until the connection ends, dispatching
+// each "event" payload and tracking the last delivered event ID for
+// gap-free resumption on reconnect.
+func (c *Client) consumeStream(ctx context.Context, resp *http.Response, sub *Subscription, lastEventID string) string {
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var data strings.Builder
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return lastEventID
+		}
+
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if data.Len() > 0 {
+				var event AuditLogEvent
+				if err := json.Unmarshal([]byte(data.String()), &event); err == nil {
+					select {
+					case sub.events <- &event:
+					case <-ctx.Done():
+						return lastEventID
+					}
+				}
+				data.Reset()
+			}
+		case strings.HasPrefix(line, "id:"):
+			lastEventID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(line, "data:"))
+		}
+	}
+	return lastEventID
+}
+
+// longPollFallback is used when the server doesn't answer with an
+// event-stream content type; it repeatedly calls QueryEvents, advancing
+// StartDate to the timestamp of the most recent event seen. That timestamp
+// is queried again on the next round, so an event can be delivered more
+// than once.
+func (c *Client) longPollFallback(ctx context.Context, query *AuditLogQuery, sub *Subscription) {
+	var q AuditLogQuery
+	if query != nil {
+		q = *query
+	}
+	if q.StartDate == nil {
+		now := time.Now()
+		q.StartDate = &now
+	}
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			results, err := c.QueryEvents(ctx, &q, 1, 100)
+			if err != nil {
+				select {
+				case sub.errors <- err:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			for i := range results.Items {
+				ev := results.Items[i]
+				select {
+				case sub.events <- &ev:
+				case <-ctx.Done():
+					return
+				}
+				if ev.Timestamp.After(*q.StartDate) {
+					t := ev.Timestamp
+					q.StartDate = &t
+				}
+			}
+		}
+	}
+}
+
+func sleepBackoff(ctx context.Context, attempt int) bool {
+	delay := time.Duration(1<<uint(attempt)) * time.Second
+	if delay > 30*time.Second {
+		delay = 30 * time.Second
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// queryParams converts an AuditLogQuery into URL params, matching the
+// filters QueryEvents already serializes.
+func queryParams(query *AuditLogQuery) map[string]string {
+	params := make(map[string]string)
+	if query == nil {
+		return params
+	}
+	if query.StartDate != nil {
+		params["start_date"] = query.StartDate.Format(time.RFC3339)
+	}
+	if query.EndDate != nil {
+		params["end_date"] = query.EndDate.Format(time.RFC3339)
+	}
+	if len(query.EventTypes) > 0 {
+		eventTypes := make([]string, len(query.EventTypes))
+		for i, et := range query.EventTypes {
+			eventTypes[i] = string(et)
+		}
+		params["event_types"] = strings.Join(eventTypes, ",")
+	}
+	if len(query.ResourceTypes) > 0 {
+		params["resource_types"] = strings.Join(query.ResourceTypes, ",")
+	}
+	if len(query.Severities) > 0 {
+		severities := make([]string, len(query.Severities))
+		for i, s := range query.Severities {
+			severities[i] = string(s)
+		}
+		params["severities"] = strings.Join(severities, ",")
+	}
+	if query.Search != nil {
+		params["search"] = *query.Search
+	}
+	return params
+}
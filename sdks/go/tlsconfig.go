@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TLSConfig declares first-class TLS settings for on-prem deployments that
+// need a custom CA bundle or mutual TLS, without the caller having to build
+// the whole *http.Transport themselves just to add it. It is only used by
+// NewClient when ClientConfig.HTTPClient is nil.
+type TLSConfig struct {
+	// CACertFile/CACertPEM trust a private CA instead of the system pool.
+	// At most one should be set.
+	CACertFile string
+	CACertPEM  []byte
+
+	// ClientCertFile+ClientKeyFile (or the PEM equivalents) present a
+	// client certificate for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+	ClientCertPEM  []byte
+	ClientKeyPEM   []byte
+
+	ServerName         string
+	InsecureSkipVerify bool
+	MinVersion         uint16
+	MaxVersion         uint16
+}
+
+// LoadTLSConfigFromDir builds a TLSConfig from the common
+// ca.crt/client.crt/client.key layout used by on-prem audit collectors.
+// client.crt/client.key are optional; if absent the resulting config only
+// carries the CA bundle.
+func LoadTLSConfigFromDir(dir string) (*TLSConfig, error) {
+	cfg := &TLSConfig{}
+
+	caPath := filepath.Join(dir, "ca.crt")
+	if _, err := os.Stat(caPath); err == nil {
+		cfg.CACertFile = caPath
+	}
+
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	if _, err := os.Stat(certPath); err == nil {
+		cfg.ClientCertFile = certPath
+		cfg.ClientKeyFile = keyPath
+	}
+
+	if cfg.CACertFile == "" && cfg.ClientCertFile == "" {
+		return nil, NewConfigurationError(fmt.Sprintf("no ca.crt or client.crt found under %s", dir))
+	}
+	return cfg, nil
+}
+
+// buildTLSConfig turns a TLSConfig into a *tls.Config for use in an
+// *http.Transport.
+func buildTLSConfig(cfg *TLSConfig) (*tls.Config, error) {
+	tlsConf := &tls.Config{
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		MinVersion:         cfg.MinVersion,
+		MaxVersion:         cfg.MaxVersion,
+	}
+	if tlsConf.MinVersion == 0 {
+		tlsConf.MinVersion = tls.VersionTLS12
+	}
+
+	caPEM := cfg.CACertPEM
+	if cfg.CACertFile != "" {
+		data, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("failed to read CA cert file: %v", err))
+		}
+		caPEM = data
+	}
+	if len(caPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, NewConfigurationError("failed to parse CA certificate PEM")
+		}
+		tlsConf.RootCAs = pool
+	}
+
+	certPEM, keyPEM := cfg.ClientCertPEM, cfg.ClientKeyPEM
+	if cfg.ClientCertFile != "" {
+		cert, err := os.ReadFile(cfg.ClientCertFile)
+		if err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("failed to read client cert file: %v", err))
+		}
+		key, err := os.ReadFile(cfg.ClientKeyFile)
+		if err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("failed to read client key file: %v", err))
+		}
+		certPEM, keyPEM = cert, key
+	}
+	if len(certPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, NewConfigurationError(fmt.Sprintf("failed to load client certificate: %v", err))
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConf, nil
+}
@@ -0,0 +1,185 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ClientOptions configures per-operation deadlines and the retry/transport
+// chain used by a Client, independent of whatever deadline the caller's
+// ctx carries. This mirrors the split-deadline pattern used elsewhere for
+// network clients: a slow export shouldn't be bound by the same timeout as
+// a fast ingest call, and vice versa.
+type ClientOptions struct {
+	// ConnectTimeout bounds establishing the TCP/TLS connection.
+	ConnectTimeout time.Duration
+	// WriteTimeout bounds CreateEvent/CreateEventsBatch requests.
+	WriteTimeout time.Duration
+	// ReadTimeout bounds QueryEvents/ExportEvents requests.
+	ReadTimeout time.Duration
+	// MaxRetries is the maximum number of retry attempts performed by the
+	// retry transport (in addition to the initial attempt).
+	MaxRetries int
+	// Retry decides whether a failed attempt should be retried and, if so,
+	// how long to wait first. A nil Retry uses exponential backoff.
+	Retry func(attempt int, err error) (delay time.Duration, retry bool)
+	// Transport, if set, is wrapped by the retry/timeout middlewares (and by
+	// authTransport for clients without an API key) instead of a default
+	// *http.Transport. Use this to inject an
+	// OpenTelemetry-instrumented transport or similar.
+	Transport http.RoundTripper
+}
+
+func (o *ClientOptions) setDefaults() {
+	if o.ConnectTimeout == 0 {
+		o.ConnectTimeout = 10 * time.Second
+	}
+	if o.WriteTimeout == 0 {
+		o.WriteTimeout = 30 * time.Second
+	}
+	if o.ReadTimeout == 0 {
+		o.ReadTimeout = 60 * time.Second
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+	if o.Retry == nil {
+		o.Retry = defaultRetryDecider
+	}
+}
+
+func defaultRetryDecider(attempt int, err error) (time.Duration, bool) {
+	if !IsRetryableError(err) {
+		return 0, false
+	}
+	return time.Second * time.Duration(1<<uint(attempt)), true
+}
+
+// NewClientWithOptions creates a Client whose transport is a chain of
+// retryTransport and timeoutTransport middlewares built from clientOpts,
+// wrapped by authTransport when config has no APIKey, so each layer can be
+// replaced or wrapped independently (e.g. to inject tracing) via
+// clientOpts.Transport.
+func NewClientWithOptions(config ClientConfig, clientOpts ClientOptions) (*Client, error) {
+	clientOpts.setDefaults()
+
+	base := clientOpts.Transport
+	if base == nil {
+		base = &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout: clientOpts.ConnectTimeout,
+			}).DialContext,
+		}
+	}
+
+	config.HTTPClient = &http.Client{
+		Transport: &retryTransport{
+			next:       &timeoutTransport{next: base, opts: clientOpts},
+			maxRetries: clientOpts.MaxRetries,
+			retry:      clientOpts.Retry,
+		},
+	}
+
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retries now happen in retryTransport; makeRequest's own loop should
+	// issue each attempt exactly once.
+	client.maxRetries = 0
+	return client, nil
+}
+
+// retryTransport retries failed requests per clientOpts.Retry. The request
+// body must support GetBody (as http.NewRequestWithContext arranges for
+// []byte/bytes.Reader bodies) so it can be replayed on retry.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	retry      func(attempt int, err error) (time.Duration, bool)
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= t.maxRetries; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			clone := req.Clone(req.Context())
+			clone.Body = body
+			attemptReq = clone
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err == nil && resp.StatusCode < 500 {
+			return resp, nil
+		}
+
+		if err != nil {
+			lastErr = NewNetworkError(err.Error(), err)
+		} else {
+			lastErr = NewServerError(resp.Status, resp.StatusCode)
+			resp.Body.Close()
+		}
+
+		if attempt == t.maxRetries {
+			break
+		}
+		delay, ok := t.retry(attempt, lastErr)
+		if !ok {
+			break
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, lastErr
+}
+
+// timeoutTransport applies ReadTimeout to GET requests and WriteTimeout to
+// everything else, bounding each request independently of the caller's ctx.
+type timeoutTransport struct {
+	next http.RoundTripper
+	opts ClientOptions
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.opts.WriteTimeout
+	if req.Method == http.MethodGet {
+		timeout = t.opts.ReadTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Tie the cancel to the response body so the deadline spans reading it.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// authTransport injects the client's current auth headers on every request
+// and transparently refreshes an expired JWT on a 401. See jwt_refresh.go.